@@ -0,0 +1,26 @@
+// Package avrorun holds the runtime encode/decode helpers that the Go code
+// generator stitches into a record type's generated methods. Which
+// implementation a generated type binds to is chosen per project by the
+// `--go-avro-runtime {goavro,hamba,heetch}` CLI flag; all three backends sit
+// behind the same Codec shape so a generated call site (e.g. the body of a
+// record's MarshalBinary/UnmarshalBinary methods) looks identical no matter
+// which runtime was selected. This package is mirrored verbatim into
+// generated projects, so it is kept buildable and tested against the exact
+// dependency versions pinned in the sibling go.mod.
+package avrorun
+
+// Backend identifies which Go Avro runtime library the code generator
+// targets for a given project.
+type Backend string
+
+const (
+	// GoAvro selects github.com/linkedin/goavro/v2, which encodes through an
+	// intermediate map[string]interface{} "native" representation.
+	GoAvro Backend = "goavro"
+	// Hamba selects github.com/hamba/avro/v2, which encodes directly from a
+	// tagged Go struct via reflection.
+	Hamba Backend = "hamba"
+	// Heetch selects github.com/heetch/avro, which derives the wire schema
+	// from the Go struct itself, the same approach used by its avrogo tool.
+	Heetch Backend = "heetch"
+)
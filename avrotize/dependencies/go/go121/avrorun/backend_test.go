@@ -0,0 +1,99 @@
+package avrorun
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const widgetSchema = `{
+	"type": "record",
+	"name": "Widget",
+	"fields": [
+		{"name": "id", "type": "string"},
+		{"name": "count", "type": "long"}
+	]
+}`
+
+type widget struct {
+	ID    string `avro:"id"`
+	Count int64  `avro:"count"`
+}
+
+func TestGoAvroCodecRoundTrip(t *testing.T) {
+	codec, err := NewGoAvroCodec(widgetSchema)
+	require.NoError(t, err)
+
+	native := map[string]any{"id": "w-1", "count": int64(42)}
+	data, err := codec.MarshalBinary(native)
+	require.NoError(t, err)
+
+	got, err := codec.UnmarshalBinary(data)
+	require.NoError(t, err)
+	assert.Equal(t, native, got)
+}
+
+func TestHambaCodecRoundTrip(t *testing.T) {
+	codec, err := NewHambaCodec(widgetSchema)
+	require.NoError(t, err)
+
+	in := widget{ID: "w-1", Count: 42}
+	data, err := codec.MarshalBinary(&in)
+	require.NoError(t, err)
+
+	var out widget
+	require.NoError(t, codec.UnmarshalBinary(data, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestHeetchCodecRoundTrip(t *testing.T) {
+	var codec HeetchCodec
+
+	in := widget{ID: "w-1", Count: 42}
+	data, wType, err := codec.MarshalBinary(in)
+	require.NoError(t, err)
+
+	var out widget
+	_, err = codec.UnmarshalBinary(data, &out, wType)
+	require.NoError(t, err)
+	assert.Equal(t, in, out)
+}
+
+func TestDecimalRoundTrip(t *testing.T) {
+	for _, unscaled := range []int64{0, 1, -1, 12345, -12345, 127, -128, 128} {
+		b := DecimalToBytes(big.NewInt(unscaled))
+		got := BytesToDecimal(b)
+		assert.Equal(t, unscaled, got.Int64(), "unscaled=%d bytes=%x", unscaled, b)
+	}
+}
+
+func TestDecimalToBytesMinimalLength(t *testing.T) {
+	cases := []struct {
+		unscaled int64
+		wantLen  int
+	}{
+		{-128, 1},
+		{-32768, 2},
+		{-129, 2},
+		{127, 1},
+		{128, 2},
+	}
+	for _, c := range cases {
+		b := DecimalToBytes(big.NewInt(c.unscaled))
+		assert.Len(t, b, c.wantLen, "unscaled=%d bytes=%x", c.unscaled, b)
+	}
+}
+
+func TestTimestampMicrosRoundTrip(t *testing.T) {
+	now := time.Date(2024, 3, 15, 10, 30, 0, 123456000, time.UTC)
+	micros := TimeToTimestampMicros(now)
+	assert.Equal(t, now, TimestampMicrosToTime(micros))
+}
+
+func TestValidateUUID(t *testing.T) {
+	assert.NoError(t, ValidateUUID("f47ac10b-58cc-4372-a567-0e02b2c3d479"))
+	assert.Error(t, ValidateUUID("not-a-uuid"))
+}
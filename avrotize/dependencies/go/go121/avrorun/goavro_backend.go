@@ -0,0 +1,34 @@
+package avrorun
+
+import goavro "github.com/linkedin/goavro/v2"
+
+// GoAvroCodec wraps a linkedin/goavro/v2 codec so generated types can encode
+// and decode through the shared backend shape. Unlike Hamba and Heetch,
+// goavro has no struct-tag support, so generated MarshalBinary/UnmarshalBinary
+// methods build an intermediate map[string]any "native" value themselves
+// (decimal, uuid and timestamp-micros fields are converted with the helpers
+// in logical.go before being placed into that map).
+type GoAvroCodec struct {
+	codec *goavro.Codec
+}
+
+// NewGoAvroCodec parses schemaJSON once; the generator caches the result on
+// the record type so repeated Marshal/Unmarshal calls avoid re-parsing.
+func NewGoAvroCodec(schemaJSON string) (*GoAvroCodec, error) {
+	codec, err := goavro.NewCodec(schemaJSON)
+	if err != nil {
+		return nil, err
+	}
+	return &GoAvroCodec{codec: codec}, nil
+}
+
+// MarshalBinary converts a native map value to Avro binary.
+func (c *GoAvroCodec) MarshalBinary(native any) ([]byte, error) {
+	return c.codec.BinaryFromNative(nil, native)
+}
+
+// UnmarshalBinary converts Avro binary back to a native map value.
+func (c *GoAvroCodec) UnmarshalBinary(data []byte) (any, error) {
+	native, _, err := c.codec.NativeFromBinary(data)
+	return native, err
+}
@@ -0,0 +1,31 @@
+package avrorun
+
+import avro "github.com/hamba/avro/v2"
+
+// HambaCodec wraps a hamba/avro/v2 schema for struct-tag-driven encoding.
+// Unlike GoAvroCodec, generated types pass their own struct pointer directly;
+// hamba derives field layout from `avro:"..."` struct tags and handles
+// decimal, uuid and timestamp-micros logical types itself via its own
+// LogicalSchema support, so generated code needs no manual conversion step.
+type HambaCodec struct {
+	schema avro.Schema
+}
+
+// NewHambaCodec parses schemaJSON once for reuse across Marshal/Unmarshal calls.
+func NewHambaCodec(schemaJSON string) (*HambaCodec, error) {
+	schema, err := avro.Parse(schemaJSON)
+	if err != nil {
+		return nil, err
+	}
+	return &HambaCodec{schema: schema}, nil
+}
+
+// MarshalBinary encodes v, a pointer to the generated struct, to Avro binary.
+func (c *HambaCodec) MarshalBinary(v any) ([]byte, error) {
+	return avro.Marshal(c.schema, v)
+}
+
+// UnmarshalBinary decodes Avro binary into v, a pointer to the generated struct.
+func (c *HambaCodec) UnmarshalBinary(data []byte, v any) error {
+	return avro.Unmarshal(c.schema, data, v)
+}
@@ -0,0 +1,26 @@
+package avrorun
+
+import heetchavro "github.com/heetch/avro"
+
+// HeetchCodec derives its wire schema from the target struct's Go type via
+// reflection, the same approach used by heetch/avro's avrogo generation
+// tool. No separate schema JSON is supplied at encode time; the returned
+// *heetchavro.Type is the writer schema and must be carried alongside the
+// encoded bytes (the schema-registry wrapper in the registry subpackage does
+// this via the Confluent wire format's schema ID).
+type HeetchCodec struct{}
+
+// MarshalBinary encodes v, the generated struct by value, to Avro binary,
+// returning the schema that was derived from v's type. Pass the struct by
+// value, not a pointer: heetch/avro maps *T to a ["null", T] union, which is
+// not what a top-level record encode wants.
+func (HeetchCodec) MarshalBinary(v any) ([]byte, *heetchavro.Type, error) {
+	return heetchavro.Marshal(v)
+}
+
+// UnmarshalBinary decodes data into v, a pointer to the generated struct,
+// using the writer schema wType, which callers typically obtained from a
+// schema registry lookup.
+func (HeetchCodec) UnmarshalBinary(data []byte, v any, wType *heetchavro.Type) (*heetchavro.Type, error) {
+	return heetchavro.Unmarshal(data, v, wType)
+}
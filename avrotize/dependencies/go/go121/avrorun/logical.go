@@ -0,0 +1,64 @@
+package avrorun
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"time"
+)
+
+// TimeToTimestampMicros converts t to the int64 microsecond count that the
+// Avro "timestamp-micros" logical type carries on the wire.
+func TimeToTimestampMicros(t time.Time) int64 {
+	return t.UnixMicro()
+}
+
+// TimestampMicrosToTime is the inverse of TimeToTimestampMicros.
+func TimestampMicrosToTime(micros int64) time.Time {
+	return time.UnixMicro(micros).UTC()
+}
+
+// DecimalToBytes encodes an unscaled integer as the two's-complement,
+// big-endian byte slice the Avro "decimal" logical type requires. scale is
+// the schema's declared decimal scale and is not encoded into the bytes
+// themselves; callers track it alongside the schema as hamba and heetch do.
+func DecimalToBytes(unscaled *big.Int) []byte {
+	if unscaled.Sign() >= 0 {
+		b := unscaled.Bytes()
+		if len(b) == 0 || b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return b
+	}
+	abs := new(big.Int).Neg(unscaled)
+	nBytes := new(big.Int).Sub(abs, big.NewInt(1)).BitLen()/8 + 1
+	mod := new(big.Int).Lsh(big.NewInt(1), uint(nBytes)*8)
+	twosComplement := new(big.Int).Add(mod, unscaled)
+	b := twosComplement.Bytes()
+	for len(b) < nBytes {
+		b = append([]byte{0}, b...)
+	}
+	return b
+}
+
+// BytesToDecimal is the inverse of DecimalToBytes.
+func BytesToDecimal(b []byte) *big.Int {
+	v := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		mod := new(big.Int).Lsh(big.NewInt(1), uint(len(b))*8)
+		v.Sub(v, mod)
+	}
+	return v
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// ValidateUUID checks that s is a syntactically valid RFC 4122 string, which
+// is all the Avro "uuid" logical type requires: the value is carried as a
+// plain string on the wire, with no binary conversion needed.
+func ValidateUUID(s string) error {
+	if !uuidPattern.MatchString(s) {
+		return fmt.Errorf("avrorun: %q is not a valid uuid logical type value", s)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Package example shows the shape of code the Go generator emits for a
+// single Avro record when --emit-tests and --emit-mocks are both set: the
+// record struct and its schema constant, a Producer/Consumer interface pair
+// (so downstream services can substitute a mock broker client), and the
+// go:generate directive that produces their mock. See widget_avro_test.go
+// for the accompanying generated test.
+package example
+
+import (
+	"context"
+
+	avro "github.com/hamba/avro/v2"
+
+	"avrotize_dependencies/avrorun"
+)
+
+// Widget is a generated record type.
+type Widget struct {
+	ID    string `avro:"id" json:"id"`
+	Count int64  `avro:"count" json:"count"`
+}
+
+// WidgetSchema is the canonical schema the generator embeds next to Widget
+// so the runtime codec can be built without a schema registry round-trip.
+const WidgetSchema = `{
+	"type": "record",
+	"name": "Widget",
+	"fields": [
+		{"name": "id", "type": "string"},
+		{"name": "count", "type": "long"}
+	]
+}`
+
+// widgetCodec is built once; this example was generated with
+// --go-avro-runtime hamba, selected by the project's --go-avro-runtime flag.
+var widgetCodec = mustWidgetCodec()
+
+func mustWidgetCodec() *avrorun.HambaCodec {
+	codec, err := avrorun.NewHambaCodec(WidgetSchema)
+	if err != nil {
+		panic(err)
+	}
+	return codec
+}
+
+// MarshalBinary encodes w as Avro binary.
+func (w *Widget) MarshalBinary() ([]byte, error) {
+	return widgetCodec.MarshalBinary(w)
+}
+
+// UnmarshalBinary decodes Avro binary into w.
+func (w *Widget) UnmarshalBinary(data []byte) error {
+	return widgetCodec.UnmarshalBinary(data, w)
+}
+
+// Fingerprint returns the SHA256 fingerprint of Widget's schema, stable
+// across regenerations as long as the schema itself doesn't change.
+func (w *Widget) Fingerprint() [32]byte {
+	return avro.MustParse(WidgetSchema).Fingerprint()
+}
+
+// WidgetProducer is implemented by anything that can publish a Widget.
+// Generated so downstream services can inject a mock in tests instead of a
+// concrete broker client.
+//
+//go:generate mockgen -source=widget.go -destination=widget_mock.go -package=example
+type WidgetProducer interface {
+	Produce(ctx context.Context, w *Widget) error
+}
+
+// WidgetConsumer is implemented by anything that can receive a Widget.
+type WidgetConsumer interface {
+	Consume(ctx context.Context) (*Widget, error)
+}
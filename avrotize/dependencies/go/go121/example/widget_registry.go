@@ -0,0 +1,48 @@
+package example
+
+import (
+	"context"
+	"fmt"
+
+	avro "github.com/hamba/avro/v2"
+
+	"avrotize_dependencies/registry"
+)
+
+// WidgetSubject is the Schema Registry subject Widget registers itself
+// under, following the Confluent convention of "<topic>-value".
+const WidgetSubject = "widget-value"
+
+// MarshalConfluent encodes w as Avro binary wrapped in the Confluent wire
+// format (magic byte + 4-byte schema ID), registering Widget's schema with
+// client if it isn't already known. Generated only when a project is built
+// with --schema-registry.
+func (w *Widget) MarshalConfluent(ctx context.Context, client registry.Client) ([]byte, error) {
+	id, err := client.Register(ctx, WidgetSubject, WidgetSchema)
+	if err != nil {
+		return nil, fmt.Errorf("example: register %s schema: %w", WidgetSubject, err)
+	}
+	payload, err := w.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return registry.EncodeConfluent(id, payload), nil
+}
+
+// UnmarshalConfluent decodes Confluent-wire-format data into w, fetching the
+// writer schema identified by the embedded schema ID from client.
+func (w *Widget) UnmarshalConfluent(ctx context.Context, client registry.Client, data []byte) error {
+	id, payload, err := registry.DecodeConfluent(data)
+	if err != nil {
+		return err
+	}
+	writerSchemaJSON, err := client.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("example: look up schema id %d: %w", id, err)
+	}
+	writerSchema, err := avro.Parse(writerSchemaJSON)
+	if err != nil {
+		return fmt.Errorf("example: parse writer schema for id %d: %w", id, err)
+	}
+	return avro.Unmarshal(writerSchema, payload, w)
+}
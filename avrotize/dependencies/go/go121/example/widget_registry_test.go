@@ -0,0 +1,53 @@
+package example
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRegistryClient is an in-memory registry.Client for tests, avoiding a
+// real Confluent Schema Registry dependency.
+type fakeRegistryClient struct {
+	nextID  int
+	schemas map[int]string
+	bySub   map[string]int
+}
+
+func newFakeRegistryClient() *fakeRegistryClient {
+	return &fakeRegistryClient{schemas: map[int]string{}, bySub: map[string]int{}}
+}
+
+func (f *fakeRegistryClient) Register(ctx context.Context, subject, schema string) (int, error) {
+	if id, ok := f.bySub[subject]; ok {
+		return id, nil
+	}
+	f.nextID++
+	f.schemas[f.nextID] = schema
+	f.bySub[subject] = f.nextID
+	return f.nextID, nil
+}
+
+func (f *fakeRegistryClient) GetBySubject(ctx context.Context, subject string) (int, string, error) {
+	id := f.bySub[subject]
+	return id, f.schemas[id], nil
+}
+
+func (f *fakeRegistryClient) GetByID(ctx context.Context, id int) (string, error) {
+	return f.schemas[id], nil
+}
+
+func TestWidgetConfluentRoundTrip(t *testing.T) {
+	client := newFakeRegistryClient()
+	in := &Widget{ID: "w-1", Count: 7}
+
+	data, err := in.MarshalConfluent(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x00), data[0])
+
+	out := &Widget{}
+	require.NoError(t, out.UnmarshalConfluent(context.Background(), client, data))
+	assert.Equal(t, in, out)
+}
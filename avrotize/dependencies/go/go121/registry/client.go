@@ -0,0 +1,24 @@
+// Package registry provides a small Confluent Schema Registry client, used
+// by generated types' MarshalConfluent/UnmarshalConfluent methods (see
+// wire.go) when a project is generated with the --schema-registry flag. It
+// is mirrored into generated projects alongside the avrorun package, so it
+// is kept buildable and tested against the exact dependency versions pinned
+// in the central go.mod.
+package registry
+
+import (
+	"context"
+)
+
+// Client is the subset of the Confluent Schema Registry REST API that
+// generated MarshalConfluent/UnmarshalConfluent methods need. It is an
+// interface, not a concrete type, so generated code and tests can swap in a
+// fake without doing real HTTP calls.
+type Client interface {
+	// Register registers schema under subject, returning its schema ID.
+	Register(ctx context.Context, subject, schema string) (id int, err error)
+	// GetBySubject returns the latest schema ID and schema registered under subject.
+	GetBySubject(ctx context.Context, subject string) (id int, schema string, err error)
+	// GetByID returns the schema registered under id.
+	GetByID(ctx context.Context, id int) (schema string, err error)
+}
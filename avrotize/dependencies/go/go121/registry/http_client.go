@@ -0,0 +1,177 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// defaultCacheSize bounds how many schema-ID lookups an HTTPClient keeps in
+// memory; schemas are immutable once registered, so a plain LRU cache (no
+// TTL/eviction callback) is sufficient.
+const defaultCacheSize = 256
+
+// AuthFunc decorates an outgoing request with credentials, e.g. HTTP basic
+// auth or a bearer token. It is pluggable so generated clients aren't tied
+// to one auth scheme.
+type AuthFunc func(*http.Request)
+
+// HTTPClient is a Client implementation that talks to a real Confluent
+// Schema Registry over HTTP, caching GetByID lookups in an LRU cache since
+// schema IDs are immutable once assigned.
+type HTTPClient struct {
+	baseURL    string
+	httpClient *http.Client
+	auth       AuthFunc
+	byID       *lru.Cache[int, string]
+	bySubject  *lru.Cache[subjectSchema, int]
+}
+
+// subjectSchema keys the Register cache on the exact (subject, schema) pair:
+// a subject can have many versions, each assigned its own schema ID, so the
+// cache must not return a stale ID for a subject whose schema has changed.
+type subjectSchema struct {
+	subject string
+	schema  string
+}
+
+// HTTPClientOption configures an HTTPClient.
+type HTTPClientOption func(*HTTPClient)
+
+// WithHTTPClient overrides the default http.Client, e.g. to set timeouts or
+// a custom transport.
+func WithHTTPClient(c *http.Client) HTTPClientOption {
+	return func(h *HTTPClient) { h.httpClient = c }
+}
+
+// WithAuth attaches an AuthFunc that decorates every outgoing request.
+func WithAuth(auth AuthFunc) HTTPClientOption {
+	return func(h *HTTPClient) { h.auth = auth }
+}
+
+// WithCacheSize overrides the default GetByID cache size.
+func WithCacheSize(size int) HTTPClientOption {
+	return func(h *HTTPClient) {
+		cache, err := lru.New[int, string](size)
+		if err != nil {
+			panic(err)
+		}
+		h.byID = cache
+	}
+}
+
+// NewHTTPClient returns a Client for the registry at baseURL, e.g.
+// "https://schema-registry.example.com".
+func NewHTTPClient(baseURL string, opts ...HTTPClientOption) *HTTPClient {
+	byID, err := lru.New[int, string](defaultCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	bySubject, err := lru.New[subjectSchema, int](defaultCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	h := &HTTPClient{
+		baseURL:    baseURL,
+		httpClient: http.DefaultClient,
+		byID:       byID,
+		bySubject:  bySubject,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+type registerRequest struct {
+	Schema string `json:"schema"`
+}
+
+type registerResponse struct {
+	ID int `json:"id"`
+}
+
+type schemaResponse struct {
+	Schema string `json:"schema"`
+}
+
+type subjectVersionResponse struct {
+	ID     int    `json:"id"`
+	Schema string `json:"schema"`
+}
+
+// Register implements Client, serving from cache when possible: the exact
+// same schema registered twice under the same subject always gets back the
+// same ID, but a subject can carry many distinct schema versions, each with
+// its own ID, so the cache is keyed on the (subject, schema) pair rather
+// than on the subject alone.
+func (h *HTTPClient) Register(ctx context.Context, subject, schema string) (int, error) {
+	key := subjectSchema{subject: subject, schema: schema}
+	if id, ok := h.bySubject.Get(key); ok {
+		return id, nil
+	}
+	body, err := json.Marshal(registerRequest{Schema: schema})
+	if err != nil {
+		return 0, err
+	}
+	reqURL := fmt.Sprintf("%s/subjects/%s/versions", h.baseURL, url.PathEscape(subject))
+	var resp registerResponse
+	if err := h.do(ctx, http.MethodPost, reqURL, body, &resp); err != nil {
+		return 0, err
+	}
+	h.byID.Add(resp.ID, schema)
+	h.bySubject.Add(key, resp.ID)
+	return resp.ID, nil
+}
+
+// GetBySubject implements Client.
+func (h *HTTPClient) GetBySubject(ctx context.Context, subject string) (int, string, error) {
+	reqURL := fmt.Sprintf("%s/subjects/%s/versions/latest", h.baseURL, url.PathEscape(subject))
+	var resp subjectVersionResponse
+	if err := h.do(ctx, http.MethodGet, reqURL, nil, &resp); err != nil {
+		return 0, "", err
+	}
+	h.byID.Add(resp.ID, resp.Schema)
+	h.bySubject.Add(subjectSchema{subject: subject, schema: resp.Schema}, resp.ID)
+	return resp.ID, resp.Schema, nil
+}
+
+// GetByID implements Client, serving from the LRU cache when possible.
+func (h *HTTPClient) GetByID(ctx context.Context, id int) (string, error) {
+	if schema, ok := h.byID.Get(id); ok {
+		return schema, nil
+	}
+	reqURL := fmt.Sprintf("%s/schemas/ids/%s", h.baseURL, strconv.Itoa(id))
+	var resp schemaResponse
+	if err := h.do(ctx, http.MethodGet, reqURL, nil, &resp); err != nil {
+		return "", err
+	}
+	h.byID.Add(id, resp.Schema)
+	return resp.Schema, nil
+}
+
+func (h *HTTPClient) do(ctx context.Context, method, url string, body []byte, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	if h.auth != nil {
+		h.auth(req)
+	}
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("registry: %s %s: unexpected status %s", method, url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
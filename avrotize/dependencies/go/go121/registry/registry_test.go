@@ -0,0 +1,133 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWireRoundTrip(t *testing.T) {
+	payload := []byte{0x02, 0x61}
+	encoded := EncodeConfluent(42, payload)
+
+	id, got, err := DecodeConfluent(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, 42, id)
+	assert.Equal(t, payload, got)
+}
+
+func TestDecodeConfluentRejectsBadMagicByte(t *testing.T) {
+	data := EncodeConfluent(1, []byte{0x00})
+	data[0] = 0x01
+	_, _, err := DecodeConfluent(data)
+	assert.Error(t, err)
+}
+
+func TestDecodeConfluentRejectsShortMessage(t *testing.T) {
+	_, _, err := DecodeConfluent([]byte{0x00, 0x01})
+	assert.Error(t, err)
+}
+
+func TestHTTPClientRegisterAndGetByID(t *testing.T) {
+	const schema = `{"type":"string"}`
+	var gotAuth string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/subjects/widget-value/versions":
+			var req registerRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, schema, req.Schema)
+			_ = json.NewEncoder(w).Encode(registerResponse{ID: 7})
+		case r.Method == http.MethodGet && r.URL.Path == "/schemas/ids/7":
+			t.Fatal("GetByID should be served from cache after Register, not hit the network")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	client := NewHTTPClient(srv.URL, WithAuth(func(r *http.Request) {
+		r.Header.Set("Authorization", "Bearer test-token")
+	}))
+
+	id, err := client.Register(context.Background(), "widget-value", schema)
+	require.NoError(t, err)
+	assert.Equal(t, 7, id)
+	assert.Equal(t, "Bearer test-token", gotAuth)
+
+	got, err := client.GetByID(context.Background(), 7)
+	require.NoError(t, err)
+	assert.Equal(t, schema, got)
+
+	id, err = client.Register(context.Background(), "widget-value", schema)
+	require.NoError(t, err, "repeat Register for a known subject must be served from cache")
+	assert.Equal(t, 7, id)
+}
+
+func TestHTTPClientRegisterNewSchemaForKnownSubject(t *testing.T) {
+	const subjectName = "widget-value"
+	const schemaV1 = `{"type":"string"}`
+	const schemaV2 = `{"type":"long"}`
+	calls := 0
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/subjects/"+subjectName+"/versions", r.URL.Path)
+		var req registerRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		calls++
+		switch req.Schema {
+		case schemaV1:
+			_ = json.NewEncoder(w).Encode(registerResponse{ID: 7})
+		case schemaV2:
+			_ = json.NewEncoder(w).Encode(registerResponse{ID: 8})
+		default:
+			t.Fatalf("unexpected schema %q", req.Schema)
+		}
+	}))
+	defer srv.Close()
+
+	client := NewHTTPClient(srv.URL)
+
+	id, err := client.Register(context.Background(), subjectName, schemaV1)
+	require.NoError(t, err)
+	assert.Equal(t, 7, id)
+
+	// Same subject, same schema: served from cache, no second request.
+	id, err = client.Register(context.Background(), subjectName, schemaV1)
+	require.NoError(t, err)
+	assert.Equal(t, 7, id)
+	assert.Equal(t, 1, calls)
+
+	// Same subject, a new schema version: must not return the stale ID 7.
+	id, err = client.Register(context.Background(), subjectName, schemaV2)
+	require.NoError(t, err)
+	assert.Equal(t, 8, id)
+	assert.Equal(t, 2, calls)
+}
+
+func TestHTTPClientEscapesSubjectInURL(t *testing.T) {
+	const schema = `{"type":"string"}`
+	const subject = "weird/subject?name"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The subject is percent-encoded on the wire (EscapedPath), but
+		// net/http decodes r.URL.Path back to the literal subject; a
+		// matching pair of both proves the registry saw one clean
+		// /subjects/<subject>/versions request rather than the path being
+		// split apart by an unescaped '/' or '?' in the subject.
+		assert.Equal(t, "/subjects/"+subject+"/versions", r.URL.Path)
+		_ = json.NewEncoder(w).Encode(registerResponse{ID: 1})
+	}))
+	defer srv.Close()
+
+	client := NewHTTPClient(srv.URL)
+	_, err := client.Register(context.Background(), subject, schema)
+	require.NoError(t, err)
+}
@@ -0,0 +1,39 @@
+package registry
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// magicByte prefixes every Confluent-wire-format-encoded message.
+const magicByte byte = 0x00
+
+// headerLen is the magic byte plus the 4-byte big-endian schema ID.
+const headerLen = 5
+
+// EncodeConfluent prefixes payload (the Avro binary encoding of a record)
+// with the Confluent wire format header: a magic byte followed by the
+// 4-byte big-endian schema ID. Generated MarshalConfluent methods call this
+// after registering or looking up the record's schema ID.
+func EncodeConfluent(schemaID int, payload []byte) []byte {
+	out := make([]byte, headerLen+len(payload))
+	out[0] = magicByte
+	binary.BigEndian.PutUint32(out[1:headerLen], uint32(schemaID))
+	copy(out[headerLen:], payload)
+	return out
+}
+
+// DecodeConfluent splits Confluent-wire-format-encoded data into its schema
+// ID and Avro binary payload. Generated UnmarshalConfluent methods use the
+// returned schema ID to fetch the writer schema from a Client before
+// decoding the payload.
+func DecodeConfluent(data []byte) (schemaID int, payload []byte, err error) {
+	if len(data) < headerLen {
+		return 0, nil, fmt.Errorf("registry: message too short to contain a Confluent wire-format header")
+	}
+	if data[0] != magicByte {
+		return 0, nil, fmt.Errorf("registry: unexpected magic byte 0x%02x, want 0x%02x", data[0], magicByte)
+	}
+	schemaID = int(binary.BigEndian.Uint32(data[1:headerLen]))
+	return schemaID, data[headerLen:], nil
+}
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// AvroSchema is an Avrotize intermediate schema node, modeled as a plain JSON
+// value (string, map or slice) rather than a fixed struct, since a schema can
+// be a bare type name, a union array, or a record/enum/array/map object.
+type AvroSchema = any
+
+// SchemasForPackage loads the Go package(s) matching pattern and returns one
+// Avrotize record schema per exported struct type, keyed by struct name.
+func SchemasForPackage(pattern string) (map[string]AvroSchema, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedSyntax | packages.NeedImports,
+	}
+	pkgs, err := packages.Load(cfg, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("loading package %q: %w", pattern, err)
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		return nil, fmt.Errorf("package %q has errors", pattern)
+	}
+
+	w := &walker{seen: map[string]AvroSchema{}}
+	out := map[string]AvroSchema{}
+	for _, pkg := range pkgs {
+		scope := pkg.Types.Scope()
+		for _, name := range scope.Names() {
+			if !isExported(name) {
+				continue
+			}
+			obj, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok {
+				continue
+			}
+			structType, ok := obj.Type().Underlying().(*types.Struct)
+			if !ok {
+				continue
+			}
+			schema, err := w.structSchema(name, pkg.Name, structType)
+			if err != nil {
+				return nil, fmt.Errorf("struct %s: %w", name, err)
+			}
+			out[name] = schema
+		}
+	}
+	return out, nil
+}
+
+func isExported(name string) bool {
+	return len(name) > 0 && strings.ToUpper(name[:1]) == name[:1]
+}
+
+// walker tracks named types already converted, so a type referenced from
+// multiple fields (or recursively) is only defined once.
+type walker struct {
+	seen map[string]AvroSchema
+}
+
+func (w *walker) structSchema(name, namespace string, s *types.Struct) (AvroSchema, error) {
+	fields := []any{}
+	for i := 0; i < s.NumFields(); i++ {
+		v := s.Field(i)
+		tag := reflect.StructTag(s.Tag(i))
+
+		if v.Embedded() {
+			embedded, ok := v.Type().Underlying().(*types.Struct)
+			if !ok {
+				continue
+			}
+			embeddedFields, err := w.structFields(embedded)
+			if err != nil {
+				return nil, fmt.Errorf("embedded field %s: %w", v.Name(), err)
+			}
+			fields = append(fields, embeddedFields...)
+			continue
+		}
+
+		if !v.Exported() {
+			continue
+		}
+
+		fieldSchema, skip, err := w.fieldSchema(v.Type(), tag)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", v.Name(), err)
+		}
+		if skip {
+			continue
+		}
+
+		fields = append(fields, map[string]any{
+			"name": fieldName(v.Name(), tag),
+			"type": fieldSchema,
+		})
+	}
+
+	return map[string]any{
+		"type":      "record",
+		"name":      name,
+		"namespace": namespace,
+		"fields":    fields,
+	}, nil
+}
+
+func (w *walker) structFields(s *types.Struct) ([]any, error) {
+	rec, err := w.structSchema("", "", s)
+	if err != nil {
+		return nil, err
+	}
+	return rec.(map[string]any)["fields"].([]any), nil
+}
+
+// fieldName honors an "avro" tag, then a "json" tag, then falls back to the
+// Go field name, matching the precedence the avrogo tool uses.
+func fieldName(goName string, tag reflect.StructTag) string {
+	if avroTag, ok := tag.Lookup("avro"); ok {
+		if name := strings.Split(avroTag, ",")[0]; name != "" && name != "-" {
+			return name
+		}
+	}
+	if jsonTag, ok := tag.Lookup("json"); ok {
+		if name := strings.Split(jsonTag, ",")[0]; name != "" && name != "-" {
+			return name
+		}
+	}
+	return goName
+}
+
+// logicalOverride parses `avro:"name,logicalType=decimal,precision=10,scale=2"`
+// style tag options into a map merged onto the derived primitive schema.
+func logicalOverride(tag reflect.StructTag) map[string]any {
+	avroTag, ok := tag.Lookup("avro")
+	if !ok {
+		return nil
+	}
+	parts := strings.Split(avroTag, ",")
+	if len(parts) < 2 {
+		return nil
+	}
+	out := map[string]any{}
+	for _, opt := range parts[1:] {
+		kv := strings.SplitN(opt, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		key, value := kv[0], kv[1]
+		if n, err := strconv.Atoi(value); err == nil {
+			out[key] = n
+		} else {
+			out[key] = value
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// fieldSchema derives the Avrotize schema for a Go field type. The bool
+// return indicates the field should be dropped entirely (an avro:"-" tag).
+func (w *walker) fieldSchema(t types.Type, tag reflect.StructTag) (AvroSchema, bool, error) {
+	if avroTag, ok := tag.Lookup("avro"); ok && strings.Split(avroTag, ",")[0] == "-" {
+		return nil, true, nil
+	}
+
+	if named, ok := t.(*types.Named); ok && named.Obj().Pkg() != nil {
+		qualified := named.Obj().Pkg().Path() + "." + named.Obj().Name()
+		switch qualified {
+		case "time.Time":
+			return applyLogical(map[string]any{"type": "long", "logicalType": "timestamp-micros"}, tag), false, nil
+		}
+		if enumSchema, ok := w.enumSchema(named); ok {
+			return enumSchema, false, nil
+		}
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		schema, err := basicSchema(u)
+		if err != nil {
+			return nil, false, err
+		}
+		return applyLogical(schema, tag), false, nil
+	case *types.Pointer:
+		inner, skip, err := w.fieldSchema(u.Elem(), tag)
+		if err != nil || skip {
+			return nil, skip, err
+		}
+		return []any{"null", inner}, false, nil
+	case *types.Slice:
+		if basic, ok := u.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Byte {
+			return applyLogical("bytes", tag), false, nil
+		}
+		items, _, err := w.fieldSchema(u.Elem(), "")
+		if err != nil {
+			return nil, false, err
+		}
+		return map[string]any{"type": "array", "items": items}, false, nil
+	case *types.Map:
+		key, ok := u.Key().Underlying().(*types.Basic)
+		if !ok || key.Kind() != types.String {
+			return nil, false, fmt.Errorf("unsupported map key type %s (only map[string]T is supported)", u.Key())
+		}
+		values, _, err := w.fieldSchema(u.Elem(), "")
+		if err != nil {
+			return nil, false, err
+		}
+		return map[string]any{"type": "map", "values": values}, false, nil
+	case *types.Struct:
+		name := t.String()
+		if named, ok := t.(*types.Named); ok {
+			name = named.Obj().Name()
+		}
+		if cached, ok := w.seen[name]; ok {
+			return cached, false, nil
+		}
+		schema, err := w.structSchema(name, "", u)
+		if err != nil {
+			return nil, false, err
+		}
+		w.seen[name] = schema
+		return schema, false, nil
+	default:
+		return nil, false, fmt.Errorf("unsupported Go type %s", t)
+	}
+}
+
+// enumSchema recognizes the common Go enum idiom: `type X string` with a
+// `const` block of values of that type declared in the same package.
+func (w *walker) enumSchema(named *types.Named) (AvroSchema, bool) {
+	basic, ok := named.Underlying().(*types.Basic)
+	if !ok || basic.Kind() != types.String {
+		return nil, false
+	}
+	pkg := named.Obj().Pkg()
+	scope := pkg.Scope()
+	type enumConst struct {
+		pos token.Pos
+		val string
+	}
+	var consts []enumConst
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok {
+			continue
+		}
+		if namedT, ok := c.Type().(*types.Named); ok && namedT == named {
+			consts = append(consts, enumConst{pos: c.Pos(), val: constant.StringVal(c.Val())})
+		}
+	}
+	if len(consts) == 0 {
+		return nil, false
+	}
+	// scope.Names() returns identifiers in sorted order, which discards the
+	// declaration order that determines the Avro enum's on-wire ordinals;
+	// re-sort by source position to preserve it.
+	sort.Slice(consts, func(i, j int) bool { return consts[i].pos < consts[j].pos })
+	symbols := make([]string, len(consts))
+	for i, c := range consts {
+		symbols[i] = c.val
+	}
+	return map[string]any{
+		"type":    "enum",
+		"name":    named.Obj().Name(),
+		"symbols": symbols,
+	}, true
+}
+
+func applyLogical(schema AvroSchema, tag reflect.StructTag) AvroSchema {
+	override := logicalOverride(tag)
+	if override == nil {
+		return schema
+	}
+	m, ok := schema.(map[string]any)
+	if !ok {
+		m = map[string]any{"type": schema}
+	}
+	for k, v := range override {
+		m[k] = v
+	}
+	return m
+}
+
+func basicSchema(b *types.Basic) (AvroSchema, error) {
+	switch b.Kind() {
+	case types.String:
+		return "string", nil
+	case types.Bool:
+		return "boolean", nil
+	case types.Int, types.Int64, types.Uint32:
+		return "long", nil
+	case types.Int32, types.Int16, types.Int8, types.Uint16, types.Uint8:
+		return "int", nil
+	case types.Float32:
+		return "float", nil
+	case types.Float64:
+		return "double", nil
+	default:
+		return nil, fmt.Errorf("unsupported primitive type %s", b)
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemasForPackage(t *testing.T) {
+	schemas, err := SchemasForPackage("./testdata/widget")
+	require.NoError(t, err)
+
+	widget, ok := schemas["Widget"].(map[string]any)
+	require.True(t, ok, "Widget schema: %#v", schemas["Widget"])
+	assert.Equal(t, "record", widget["type"])
+
+	fields := fieldsByName(t, widget)
+
+	assert.Equal(t, "string", fields["id"], "embedded Base.ID should be inlined")
+	assert.Equal(t, "string", fields["name"], "json tag should name the field")
+
+	status, ok := fields["status"].(map[string]any)
+	require.True(t, ok, "status field: %#v", fields["status"])
+	assert.Equal(t, "enum", status["type"])
+	// Symbol order must match declaration order (ACTIVE, PENDING, INACTIVE),
+	// not alphabetical order, since it determines the enum's on-wire
+	// ordinal.
+	assert.Equal(t, []string{"ACTIVE", "PENDING", "INACTIVE"}, status["symbols"])
+
+	assert.Equal(t, map[string]any{"type": "long", "logicalType": "timestamp-micros"}, fields["created_at"])
+	assert.Equal(t, map[string]any{
+		"type": "long", "logicalType": "decimal", "precision": 10, "scale": 2,
+	}, fields["price"])
+	assert.Equal(t, []any{"null", "string"}, fields["nickname"])
+	assert.Equal(t, "bytes", fields["payload"])
+	assert.Equal(t, map[string]any{"type": "map", "values": "string"}, fields["labels"])
+
+	_, hasInternal := fields["internal"]
+	assert.False(t, hasInternal, "unexported fields must not be emitted")
+}
+
+// TestRoundTrip checks that the schema go2avro derives from a hand-written
+// Go package (testdata/widgetroundtrip) -- written to be exactly what
+// Avrotize's Go generator would emit for testdata/widget.Widget's schema --
+// is structurally equivalent to the original, modulo record name/namespace
+// and embedding, which a generator is free to flatten.
+func TestRoundTrip(t *testing.T) {
+	original, err := SchemasForPackage("./testdata/widget")
+	require.NoError(t, err)
+	regenerated, err := SchemasForPackage("./testdata/widgetroundtrip")
+	require.NoError(t, err)
+
+	originalFields := fieldsByName(t, original["Widget"].(map[string]any))
+	regeneratedFields := fieldsByName(t, regenerated["Widget"].(map[string]any))
+
+	assert.Equal(t, originalFields, regeneratedFields)
+}
+
+func fieldsByName(t *testing.T, record map[string]any) map[string]any {
+	t.Helper()
+	fields, ok := record["fields"].([]any)
+	require.True(t, ok, "record has no fields: %#v", record)
+
+	out := map[string]any{}
+	names := []string{}
+	for _, f := range fields {
+		field := f.(map[string]any)
+		name := field["name"].(string)
+		out[name] = field["type"]
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return out
+}
@@ -0,0 +1,53 @@
+// Command go2avro derives Avrotize intermediate schemas from the exported
+// struct types of an existing Go package, mirroring the reverse direction of
+// Avrotize's Go code generator: where that generator turns an Avrotize
+// schema into Go types, go2avro turns Go types back into an Avrotize schema
+// that can be fed into any of Avrotize's other target-language generators.
+//
+// Usage:
+//
+//	go2avro -pkg <import path or pattern> [-o out.avsc]
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	pkgPattern := flag.String("pkg", "", "import path or pattern of the Go package to load (required)")
+	outPath := flag.String("o", "", "output file for the derived Avrotize schema (default: stdout)")
+	flag.Parse()
+
+	if *pkgPattern == "" {
+		fmt.Fprintln(os.Stderr, "go2avro: -pkg is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	schemas, err := SchemasForPackage(*pkgPattern)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "go2avro: %v\n", err)
+		os.Exit(1)
+	}
+
+	out := os.Stdout
+	if *outPath != "" {
+		f, err := os.Create(*outPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "go2avro: %v\n", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(schemas); err != nil {
+		fmt.Fprintf(os.Stderr, "go2avro: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,40 @@
+// Package widget is fixture data for avroschema_test.go: a handful of
+// exported struct types exercising the Go constructs go2avro understands.
+package widget
+
+import "time"
+
+// Status is the common Go enum idiom: a named string type with a const
+// block of values, which go2avro maps to an Avro enum.
+type Status string
+
+// Declared out of alphabetical order on purpose: "PENDING" sorts after
+// "INACTIVE" but is declared before it, so the fixture catches a walker
+// that alphabetizes symbols instead of preserving declaration order.
+const (
+	StatusActive   Status = "ACTIVE"
+	StatusPending  Status = "PENDING"
+	StatusInactive Status = "INACTIVE"
+)
+
+// Base is embedded into Widget to check that embedded-struct fields are
+// inlined into the enclosing record.
+type Base struct {
+	ID string `avro:"id"`
+}
+
+// Widget exercises tag-driven naming, logical-type overrides, pointer
+// (nullable) fields, byte slices, and map fields.
+type Widget struct {
+	Base
+
+	Name      string            `json:"name"`
+	Status    Status            `avro:"status"`
+	CreatedAt time.Time         `avro:"created_at"`
+	Price     int64             `avro:"price,logicalType=decimal,precision=10,scale=2"`
+	Nickname  *string           `avro:"nickname"`
+	Payload   []byte            `avro:"payload"`
+	Labels    map[string]string `avro:"labels"`
+
+	internal string
+}
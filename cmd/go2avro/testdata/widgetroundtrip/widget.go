@@ -0,0 +1,30 @@
+// Package widgetroundtrip is fixture data for the round-trip test in
+// avroschema_test.go: it is what Avrotize's Go generator would emit for the
+// schema derived from testdata/widget.Widget -- the same fields, flattened
+// (a generator has no reason to re-embed a one-off Base type), with no
+// unexported field, since generated code only ever contains the fields the
+// schema describes.
+package widgetroundtrip
+
+import "time"
+
+// Status mirrors testdata/widget.Status.
+type Status string
+
+const (
+	StatusActive   Status = "ACTIVE"
+	StatusPending  Status = "PENDING"
+	StatusInactive Status = "INACTIVE"
+)
+
+// Widget mirrors the fields go2avro derives from testdata/widget.Widget.
+type Widget struct {
+	ID        string            `avro:"id"`
+	Name      string            `json:"name"`
+	Status    Status            `avro:"status"`
+	CreatedAt time.Time         `avro:"created_at"`
+	Price     int64             `avro:"price,logicalType=decimal,precision=10,scale=2"`
+	Nickname  *string           `avro:"nickname"`
+	Payload   []byte            `avro:"payload"`
+	Labels    map[string]string `avro:"labels"`
+}